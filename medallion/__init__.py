@@ -7,6 +7,10 @@ to resume work from previous sessions with consistent state.
 
 __version__ = "0.1.0"
 
+# Clock and ID generator interfaces and implementations
+from medallion.clock import Clock, DeterministicClock, SystemClock
+from medallion.ids import IDGenerator, SequentialIDGenerator, UUIDGenerator
+
 # Core types
 # LLM interfaces and implementations
 from medallion.llm import MedallionLLM, StubMedallionLLM
@@ -19,6 +23,7 @@ from medallion.sqlite_store import SQLiteMedallionStore
 from medallion.store import MedallionStore
 from medallion.types import (
     Evidence,
+    IntegrityReport,
     LLMError,
     Medallion,
     MedallionError,
@@ -34,12 +39,20 @@ __all__ = [
     "Medallion",
     "MedallionScope",
     "Evidence",
+    "IntegrityReport",
     # Store interfaces and implementations
     "MedallionStore",
     "SQLiteMedallionStore",
     # LLM interfaces and implementations
     "MedallionLLM",
     "StubMedallionLLM",
+    # Clock and ID generator interfaces and implementations
+    "Clock",
+    "SystemClock",
+    "DeterministicClock",
+    "IDGenerator",
+    "UUIDGenerator",
+    "SequentialIDGenerator",
     # Session helpers
     "load_medallions_for_scope",
     "checkpoint_session",
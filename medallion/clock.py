@@ -0,0 +1,70 @@
+"""
+Clock interface and implementations.
+
+This module defines the Clock Protocol used wherever medallion timestamps are
+produced, so that tests can substitute a deterministic clock for the system
+time.
+"""
+
+from datetime import datetime, timedelta
+from typing import Protocol
+
+
+class Clock(Protocol):
+    """Abstract source of the current time."""
+
+    def now(self) -> datetime:  # pragma: no cover
+        """
+        Return the current time.
+
+        Returns:
+            The current time according to this clock
+        """
+        ...
+
+
+class SystemClock:
+    """Clock backed by the system time (datetime.now())."""
+
+    def now(self) -> datetime:
+        """Return the current local system time."""
+        return datetime.now()
+
+
+class DeterministicClock:
+    """Clock that returns a predictable sequence of times.
+
+    The first call to now() returns start; each subsequent call advances by step.
+    Useful for golden tests of stored medallions and their history.
+
+    Example:
+        ```python
+        from datetime import datetime, timedelta
+        from medallion import DeterministicClock, StubMedallionLLM
+
+        clock = DeterministicClock(datetime(2025, 1, 1), step=timedelta(seconds=1))
+        llm = StubMedallionLLM(clock=clock)
+        ```
+    """
+
+    def __init__(self, start: datetime, step: timedelta = timedelta(0)) -> None:
+        """
+        Initialize deterministic clock.
+
+        Args:
+            start: Time returned by the first call to now()
+            step: Amount the clock advances after each call (default: no advance)
+
+        Raises:
+            ValueError: If step is negative
+        """
+        if step < timedelta(0):
+            raise ValueError("step cannot be negative")
+        self._next = start
+        self._step = step
+
+    def now(self) -> datetime:
+        """Return the next time in the sequence."""
+        current = self._next
+        self._next = current + self._step
+        return current
@@ -0,0 +1,61 @@
+"""
+Medallion ID generator interface and implementations.
+
+This module defines the IDGenerator Protocol used when new medallions are
+created, so that tests can substitute predictable IDs for random ones.
+"""
+
+import uuid
+from typing import Protocol
+
+
+class IDGenerator(Protocol):
+    """Abstract source of new medallion IDs."""
+
+    def new_id(self) -> str:  # pragma: no cover
+        """
+        Return a new, unique medallion ID.
+
+        Returns:
+            A medallion ID not previously returned by this generator
+        """
+        ...
+
+
+class UUIDGenerator:
+    """ID generator producing random IDs of the form "med-<12 hex chars>"."""
+
+    def new_id(self) -> str:
+        """Return a new random medallion ID."""
+        return f"med-{uuid.uuid4().hex[:12]}"
+
+
+class SequentialIDGenerator:
+    """ID generator producing a predictable sequence of IDs.
+
+    Example:
+        ```python
+        from medallion import SequentialIDGenerator
+
+        ids = SequentialIDGenerator()
+        ids.new_id()  # "med-000001"
+        ids.new_id()  # "med-000002"
+        ```
+    """
+
+    def __init__(self, prefix: str = "med-", start: int = 1) -> None:
+        """
+        Initialize sequential ID generator.
+
+        Args:
+            prefix: String prepended to every ID (default: "med-")
+            start: Number used for the first ID (default: 1)
+        """
+        self._prefix = prefix
+        self._next = start
+
+    def new_id(self) -> str:
+        """Return the next ID in the sequence."""
+        medallion_id = f"{self._prefix}{self._next:06d}"
+        self._next += 1
+        return medallion_id
@@ -5,10 +5,10 @@ This module provides the MedallionLLM Protocol for LLM-based medallion operation
 and a stub implementation for testing.
 """
 
-import uuid
-from datetime import datetime
 from typing import Protocol
 
+from medallion.clock import Clock, SystemClock
+from medallion.ids import IDGenerator, UUIDGenerator
 from medallion.types import (
     Evidence,
     LLMError,
@@ -105,9 +105,35 @@ class StubMedallionLLM:
 
         medallion = await llm.generate(scope, evidence)
         print(f"Generated medallion: {medallion.meta.medallion_id}")
+
+        # Reproducible IDs and timestamps for golden tests
+        from datetime import datetime
+        from medallion import DeterministicClock, SequentialIDGenerator
+
+        llm = StubMedallionLLM(
+            clock=DeterministicClock(datetime(2025, 1, 1)),
+            id_generator=SequentialIDGenerator(),
+        )
         ```
     """
 
+    def __init__(
+        self,
+        clock: Clock | None = None,
+        id_generator: IDGenerator | None = None,
+    ) -> None:
+        """
+        Initialize stub LLM.
+
+        Args:
+            clock: Source of created_at/updated_at timestamps (default: SystemClock)
+            id_generator: Source of new medallion IDs (default: UUIDGenerator)
+        """
+        self.clock: Clock = clock if clock is not None else SystemClock()
+        self.id_generator: IDGenerator = (
+            id_generator if id_generator is not None else UUIDGenerator()
+        )
+
     async def generate(
         self,
         scope: MedallionScope,
@@ -136,8 +162,8 @@ class StubMedallionLLM:
         if not evidence.session_summary or not evidence.session_summary.strip():
             raise LLMError("Evidence session_summary cannot be empty")
 
-        now = datetime.now()
-        medallion_id = f"med-{uuid.uuid4().hex[:12]}"
+        now = self.clock.now()
+        medallion_id = self.id_generator.new_id()
 
         meta = MedallionMeta(
             medallion_id=medallion_id,
@@ -184,7 +210,7 @@ class StubMedallionLLM:
             new_evidence: New evidence (ignored in stub)
 
         Returns:
-            Existing medallion with updated_at changed to current time
+            Existing medallion with updated_at changed to the clock's current time
 
         Raises:
             LLMError: If new_evidence.session_summary is empty
@@ -192,7 +218,7 @@ class StubMedallionLLM:
         if not new_evidence.session_summary or not new_evidence.session_summary.strip():
             raise LLMError("Evidence session_summary cannot be empty")
 
-        now = datetime.now()
+        now = self.clock.now()
 
         # Create updated meta with new timestamp but preserved created_at
         updated_meta = MedallionMeta(
@@ -5,27 +5,39 @@ This module provides a concrete SQLite implementation of the MedallionStore
 interface for persisting and retrieving medallions.
 """
 
+import contextlib
 import json
 import sqlite3
+from datetime import datetime
 from pathlib import Path
 from typing import Any
 
 import aiosqlite
 
 from medallion.types import (
+    IntegrityReport,
     Medallion,
     MedallionScope,
     SchemaValidationError,
     StoreError,
 )
 
+DEFAULT_NAMESPACE = "default"
+
+
+def _is_aware(value: datetime) -> bool:
+    """Return True if value carries a UTC offset."""
+    return value.tzinfo is not None and value.utcoffset() is not None
+
 
 class SQLiteMedallionStore:
     """SQLite-backed implementation of MedallionStore.
 
     This class provides a concrete SQLite implementation of the MedallionStore
     protocol. It stores medallions in a SQLite database with efficient indexing
-    for scope-based queries.
+    for scope-based queries. Each store instance is bound to a namespace, so
+    several projects can share one database file without their medallions
+    mixing. Medallion IDs only need to be unique within a namespace.
 
     Example:
         ```python
@@ -44,21 +56,41 @@ class SQLiteMedallionStore:
 
             # Query by scope
             medallions = await store.get_latest_for_scope(scope, limit=10)
+
+        # Share one database between projects without mixing medallions
+        project_a = SQLiteMedallionStore("shared.db", namespace="project-a")
+        project_b = SQLiteMedallionStore("shared.db", namespace="project-b")
         ```
     """
 
-    def __init__(self, db_path: Path | str = "medallion.db") -> None:
+    def __init__(
+        self,
+        db_path: Path | str = "medallion.db",
+        namespace: str = DEFAULT_NAMESPACE,
+    ) -> None:
         """
         Initialize SQLite store.
 
         Args:
             db_path: Path to SQLite database file (default: "medallion.db")
                     Use ":memory:" for in-memory database (useful for testing)
+            namespace: Project namespace this store reads and writes (default: "default").
+                    Stores opened on the same database with different namespaces
+                    never see each other's medallions.
 
         Raises:
-            StoreError: If database initialization fails
+            StoreError: If namespace is empty or has leading/trailing whitespace,
+                or database initialization fails
         """
+        if not namespace or not namespace.strip():
+            raise StoreError("Namespace cannot be empty")
+        if namespace != namespace.strip():
+            raise StoreError(
+                f"Namespace {namespace!r} cannot have leading or trailing whitespace"
+            )
+
         self.db_path = str(db_path)
+        self.namespace = namespace
         self._conn: aiosqlite.Connection | None = None
 
     async def _ensure_initialized(self) -> None:
@@ -69,16 +101,51 @@ class SQLiteMedallionStore:
                 await self._conn.execute("PRAGMA foreign_keys = ON")
                 await self._create_schema()
                 await self._conn.commit()
-            except sqlite3.Error as e:
-                raise StoreError(f"Failed to initialize database: {e}") from e
+            except BaseException as e:
+                # Never keep a connection holding a half-applied migration: the
+                # next write's commit() would persist it
+                await self._discard_connection()
+                if isinstance(e, sqlite3.Error):
+                    raise StoreError(f"Failed to initialize database: {e}") from e
+                raise
+
+    async def _discard_connection(self) -> None:
+        """Roll back any open transaction and close the connection."""
+        conn, self._conn = self._conn, None
+        if conn is None:
+            return
+        with contextlib.suppress(sqlite3.Error):
+            await conn.rollback()
+        with contextlib.suppress(sqlite3.Error):
+            await conn.close()
 
     async def _create_schema(self) -> None:
         """Create database schema if it doesn't exist."""
         assert self._conn is not None, "Connection must be initialized"
 
-        create_table_sql = """
+        # Hold the write lock from inspection through migration, so two
+        # processes opening the same legacy file can't both rebuild it
+        await self._conn.execute("BEGIN IMMEDIATE")
+
+        # Inspect any existing table before CREATE TABLE IF NOT EXISTS hides it;
+        # maps column name to its position in the primary key (0 if not in it)
+        async with self._conn.execute("PRAGMA table_info(medallions)") as cursor:
+            existing_columns = {row[1]: row[5] for row in await cursor.fetchall()}
+        primary_key = [
+            name for name, position in sorted(existing_columns.items(), key=lambda c: c[1])
+            if position
+        ]
+
+        # Tables created before namespaces were keyed by id alone; rebuild any
+        # table not keyed by (namespace, id), keeping rows' namespace if the
+        # column exists and placing them in the default one otherwise
+        needs_rekey = bool(existing_columns) and primary_key != ["namespace", "id"]
+        if needs_rekey:
+            await self._conn.execute("ALTER TABLE medallions RENAME TO medallions_legacy")
+
+        create_table_sql = f"""
         CREATE TABLE IF NOT EXISTS medallions (
-            id TEXT PRIMARY KEY,
+            id TEXT NOT NULL,
             content_json TEXT NOT NULL,
             created_at TEXT NOT NULL,
             updated_at TEXT NOT NULL,
@@ -87,22 +154,123 @@ class SQLiteMedallionStore:
             scope_tags TEXT NOT NULL,
             knowledge_min_ts TEXT,
             knowledge_max_ts TEXT,
-            schema_version TEXT NOT NULL DEFAULT 'medallion.v1'
+            schema_version TEXT NOT NULL DEFAULT 'medallion.v1',
+            namespace TEXT NOT NULL DEFAULT '{DEFAULT_NAMESPACE}',
+            PRIMARY KEY (namespace, id)
         )
         """
         await self._conn.execute(create_table_sql)
 
+        if needs_rekey:
+            namespace_expr = (
+                "namespace" if "namespace" in existing_columns else f"'{DEFAULT_NAMESPACE}'"
+            )
+            copy_sql = f"""
+            INSERT INTO medallions (
+                id, content_json, created_at, updated_at, status,
+                scope_graph_nodes, scope_tags, knowledge_min_ts, knowledge_max_ts,
+                schema_version, namespace
+            )
+            SELECT
+                id, content_json, created_at, updated_at, status,
+                scope_graph_nodes, scope_tags, knowledge_min_ts, knowledge_max_ts,
+                schema_version, {namespace_expr}
+            FROM medallions_legacy
+            """
+            await self._conn.execute(copy_sql)
+            await self._conn.execute("DROP TABLE medallions_legacy")
+
         # Create indexes for efficient queries
         indexes = [
             "CREATE INDEX IF NOT EXISTS idx_medallions_status ON medallions(status)",
             "CREATE INDEX IF NOT EXISTS idx_medallions_updated_at ON medallions(updated_at DESC)",
             "CREATE INDEX IF NOT EXISTS idx_medallions_scope_nodes ON medallions(scope_graph_nodes)",
             "CREATE INDEX IF NOT EXISTS idx_medallions_scope_tags ON medallions(scope_tags)",
+            # Serves get_latest_for_scope: namespace and status, newest first
+            "CREATE INDEX IF NOT EXISTS idx_medallions_namespace_status_updated_at "
+            "ON medallions(namespace, status, updated_at DESC)",
         ]
 
         for index_sql in indexes:
             await self._conn.execute(index_sql)
 
+        await self._create_event_log()
+
+    async def _create_event_log(self) -> None:
+        """Create the append-only event log, backfilling it if newly created."""
+        assert self._conn is not None, "Connection must be initialized"
+
+        # Checked under the lock taken in _create_schema, so exactly one opener
+        # creates the log and it is backfilled only in that same transaction
+        async with self._conn.execute(
+            "SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'medallion_events'"
+        ) as cursor:
+            events_exist = await cursor.fetchone() is not None
+
+        # Append-only log of every version written, for history and as-of reads
+        create_events_sql = """
+        CREATE TABLE IF NOT EXISTS medallion_events (
+            seq INTEGER PRIMARY KEY AUTOINCREMENT,
+            medallion_id TEXT NOT NULL,
+            namespace TEXT NOT NULL,
+            event_type TEXT NOT NULL,
+            updated_at TEXT NOT NULL,
+            content_json TEXT NOT NULL
+        )
+        """
+        await self._conn.execute(create_events_sql)
+        await self._conn.execute(
+            "CREATE INDEX IF NOT EXISTS idx_medallion_events_lookup "
+            "ON medallion_events(medallion_id, namespace, updated_at)"
+        )
+        for operation in ("UPDATE", "DELETE"):
+            await self._conn.execute(
+                f"""
+                CREATE TRIGGER IF NOT EXISTS medallion_events_no_{operation.lower()}
+                BEFORE {operation} ON medallion_events
+                BEGIN
+                    SELECT RAISE(ABORT, 'medallion_events is append-only');
+                END
+                """
+            )
+
+        if events_exist:
+            return
+
+        # Medallions written before the log existed only have their current
+        # version; record it as 'backfilled' since earlier versions are lost
+        backfill_sql = """
+        INSERT INTO medallion_events (
+            medallion_id, namespace, event_type, updated_at, content_json
+        )
+        SELECT id, namespace, 'backfilled', updated_at, content_json
+        FROM medallions
+        ORDER BY namespace, id
+        """
+        await self._conn.execute(backfill_sql)
+
+    async def _record_event(
+        self, medallion: Medallion, event_type: str, json_str: str
+    ) -> None:
+        """Append a version to the event log (caller commits)."""
+        assert self._conn is not None, "Connection must be initialized"
+
+        insert_sql = """
+        INSERT INTO medallion_events (
+            medallion_id, namespace, event_type, updated_at, content_json
+        ) VALUES (?, ?, ?, ?, ?)
+        """
+        await self._conn.execute(
+            insert_sql,
+            (
+                medallion.meta.medallion_id,
+                self.namespace,
+                event_type,
+                medallion.meta.updated_at.isoformat(),
+                json_str,
+            ),
+        )
+
     async def create(self, medallion: Medallion) -> None:
         """
         Persist a new medallion.
@@ -111,7 +279,8 @@ class SQLiteMedallionStore:
             medallion: The medallion to persist
 
         Raises:
-            StoreError: If medallion already exists (by ID) or persistence fails
+            StoreError: If medallion already exists (by ID) in this store's namespace
+                or persistence fails
             SchemaValidationError: If medallion schema validation fails
         """
         await self._ensure_initialized()
@@ -161,8 +330,8 @@ class SQLiteMedallionStore:
             INSERT INTO medallions (
                 id, content_json, created_at, updated_at, status,
                 scope_graph_nodes, scope_tags, knowledge_min_ts, knowledge_max_ts,
-                schema_version
-            ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+                schema_version, namespace
+            ) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
             """
             await self._conn.execute(
                 insert_sql,
@@ -177,15 +346,19 @@ class SQLiteMedallionStore:
                     knowledge_min_str,
                     knowledge_max_str,
                     medallion.meta.schema_version,
+                    self.namespace,
                 ),
             )
+            await self._record_event(medallion, "created", json_str)
             await self._conn.commit()
         except sqlite3.IntegrityError as e:
             # Handle race condition if medallion was created between check and insert
+            await self._conn.rollback()
             raise StoreError(
                 f"Medallion with ID {medallion.meta.medallion_id} already exists"
             ) from e
         except sqlite3.Error as e:
+            await self._conn.rollback()
             raise StoreError(f"Failed to create medallion: {e}") from e
 
     async def update(self, medallion: Medallion) -> None:
@@ -249,7 +422,7 @@ class SQLiteMedallionStore:
                 scope_tags = ?,
                 knowledge_min_ts = ?,
                 knowledge_max_ts = ?
-            WHERE id = ?
+            WHERE id = ? AND namespace = ?
             """
             await self._conn.execute(
                 update_sql,
@@ -262,10 +435,13 @@ class SQLiteMedallionStore:
                     knowledge_min_str,
                     knowledge_max_str,
                     medallion.meta.medallion_id,
+                    self.namespace,
                 ),
             )
+            await self._record_event(medallion, "updated", json_str)
             await self._conn.commit()
         except sqlite3.Error as e:
+            await self._conn.rollback()
             raise StoreError(f"Failed to update medallion: {e}") from e
 
     async def get_by_id(self, medallion_id: str) -> Medallion | None:
@@ -276,7 +452,7 @@ class SQLiteMedallionStore:
             medallion_id: The unique identifier of the medallion
 
         Returns:
-            The medallion if found, None otherwise
+            The medallion if found in this store's namespace, None otherwise
 
         Raises:
             StoreError: If database query fails
@@ -285,8 +461,10 @@ class SQLiteMedallionStore:
         assert self._conn is not None, "Connection must be initialized"
 
         try:
-            select_sql = "SELECT content_json FROM medallions WHERE id = ?"
-            async with self._conn.execute(select_sql, (medallion_id,)) as cursor:
+            select_sql = "SELECT content_json FROM medallions WHERE id = ? AND namespace = ?"
+            async with self._conn.execute(
+                select_sql, (medallion_id, self.namespace)
+            ) as cursor:
                 row = await cursor.fetchone()
                 if row is None:
                     return None
@@ -300,6 +478,125 @@ class SQLiteMedallionStore:
                 f"Failed to deserialize medallion from JSON: {e}"
             ) from e
 
+    async def get_history(self, medallion_id: str) -> list[Medallion]:
+        """
+        Fetch every stored version of a medallion.
+
+        Args:
+            medallion_id: The unique identifier of the medallion
+
+        Returns:
+            All versions written to this store's namespace, oldest first.
+            Empty list if the medallion doesn't exist.
+
+        Raises:
+            StoreError: If database query fails
+            SchemaValidationError: If a stored version cannot be deserialized
+        """
+        await self._ensure_initialized()
+        assert self._conn is not None, "Connection must be initialized"
+
+        try:
+            select_sql = """
+            SELECT content_json FROM medallion_events
+            WHERE medallion_id = ? AND namespace = ?
+            ORDER BY seq
+            """
+            async with self._conn.execute(
+                select_sql, (medallion_id, self.namespace)
+            ) as cursor:
+                rows = await cursor.fetchall()
+        except sqlite3.Error as e:
+            raise StoreError(f"Failed to get medallion history: {e}") from e
+
+        try:
+            return [Medallion.model_validate_json(row[0]) for row in rows]
+        except Exception as e:
+            raise SchemaValidationError(
+                f"Failed to deserialize medallion from JSON: {e}"
+            ) from e
+
+    async def get_as_of(self, medallion_id: str, as_of: datetime) -> Medallion | None:
+        """
+        Fetch a medallion as it was at a point in time.
+
+        Versions are placed in time by their meta.updated_at, so this returns the
+        most recent version whose updated_at is at or before as_of. Timestamps are
+        compared as datetimes, so aware values with different UTC offsets are
+        ordered by the instant they denote.
+
+        Medallions that predate the event log only have a backfilled copy of the
+        version current when the log was added. Between their created_at and
+        that version's updated_at, that earliest recorded version is returned.
+
+        Args:
+            medallion_id: The unique identifier of the medallion
+            as_of: The point in time to read at. Must be timezone-aware if the
+                stored timestamps are, and naive if they are naive.
+
+        Returns:
+            The medallion version current at as_of, or None if the medallion
+            did not exist yet (or doesn't exist at all)
+
+        Raises:
+            StoreError: If database query fails, or as_of and a stored timestamp
+                are not both naive or both timezone-aware
+            SchemaValidationError: If the stored version cannot be deserialized
+        """
+        await self._ensure_initialized()
+        assert self._conn is not None, "Connection must be initialized"
+
+        try:
+            select_sql = """
+            SELECT event_type, updated_at, content_json FROM medallion_events
+            WHERE medallion_id = ? AND namespace = ?
+            ORDER BY seq
+            """
+            async with self._conn.execute(
+                select_sql, (medallion_id, self.namespace)
+            ) as cursor:
+                rows = await cursor.fetchall()
+        except sqlite3.Error as e:
+            raise StoreError(f"Failed to get medallion as of {as_of}: {e}") from e
+
+        as_of_aware = _is_aware(as_of)
+
+        def check_comparable(stored: datetime) -> None:
+            if _is_aware(stored) != as_of_aware:
+                raise StoreError(
+                    f"Cannot compare {'timezone-aware' if as_of_aware else 'naive'} "
+                    f"as_of {as_of.isoformat()} with "
+                    f"{'timezone-aware' if _is_aware(stored) else 'naive'} stored "
+                    f"timestamp {stored.isoformat()} for medallion {medallion_id}"
+                )
+
+        current_json: str | None = None
+        current_at: datetime | None = None
+        for _, updated_at_str, content_json in rows:
+            updated_at = datetime.fromisoformat(updated_at_str)
+            check_comparable(updated_at)
+            # Later writes win ties, matching the order versions were recorded
+            if updated_at <= as_of and (current_at is None or updated_at >= current_at):
+                current_json, current_at = content_json, updated_at
+
+        if current_json is None and rows and rows[0][0] == "backfilled":
+            current_json = rows[0][2]
+        if current_json is None:
+            return None
+        try:
+            medallion = Medallion.model_validate_json(current_json)
+        except Exception as e:
+            raise SchemaValidationError(
+                f"Failed to deserialize medallion from JSON: {e}"
+            ) from e
+
+        if current_at is None:
+            # Backfilled medallion: it did not exist yet before its created_at
+            check_comparable(medallion.meta.created_at)
+            if as_of < medallion.meta.created_at:
+                return None
+        return medallion
+
     async def get_latest_for_scope(
         self,
         scope: MedallionScope,
@@ -311,6 +608,7 @@ class SQLiteMedallionStore:
         Scope matching rules:
         - graph_nodes: Requested nodes must be a subset of stored nodes (subset match)
         - tags: Intersection matching (any tag overlap)
+        - Only medallions in this store's namespace are considered
         - Results ordered by updated_at DESC (latest first)
 
         Args:
@@ -343,22 +641,26 @@ class SQLiteMedallionStore:
                 select_sql = """
                 SELECT content_json, scope_graph_nodes
                 FROM medallions
-                WHERE status = 'active'
+                WHERE status = 'active' AND namespace = ?
                 ORDER BY updated_at DESC
                 LIMIT ?
                 """
-                async with self._conn.execute(select_sql, (limit * 5,)) as cursor:
+                async with self._conn.execute(
+                    select_sql, (self.namespace, limit * 5)
+                ) as cursor:
                     rows = await cursor.fetchall()
             else:
                 # No tags specified, get all active medallions
                 select_sql = """
                 SELECT content_json, scope_graph_nodes
                 FROM medallions
-                WHERE status = 'active'
+                WHERE status = 'active' AND namespace = ?
                 ORDER BY updated_at DESC
                 LIMIT ?
                 """
-                async with self._conn.execute(select_sql, (limit * 5,)) as cursor:
+                async with self._conn.execute(
+                    select_sql, (self.namespace, limit * 5)
+                ) as cursor:
                     rows = await cursor.fetchall()
 
             # Filter by scope matching (subset match for graph_nodes, intersection for tags)
@@ -391,6 +693,125 @@ class SQLiteMedallionStore:
         except sqlite3.Error as e:
             raise StoreError(f"Failed to get medallions for scope: {e}") from e
 
+    async def backup(self, target_path: Path | str) -> None:
+        """
+        Copy the whole database to another file.
+
+        Uses SQLite's online backup API, so it is safe to call while other
+        connections are writing. Medallions from every namespace are copied.
+
+        Args:
+            target_path: Path of the backup file (overwritten if it exists)
+
+        Raises:
+            StoreError: If the backup fails
+        """
+        await self._ensure_initialized()
+        assert self._conn is not None, "Connection must be initialized"
+
+        try:
+            # The backup runs on aiosqlite's worker thread, so the target
+            # connection must not be pinned to this one
+            target = sqlite3.connect(str(target_path), check_same_thread=False)
+            try:
+                await self._conn.backup(target)
+            finally:
+                target.close()
+        except sqlite3.Error as e:
+            raise StoreError(f"Failed to back up database to {target_path}: {e}") from e
+
+    async def check_integrity(self) -> IntegrityReport:
+        """
+        Check the database for corruption and inconsistent medallion rows.
+
+        Runs SQLite's integrity_check and foreign_key_check pragmas over the
+        whole database, then, within this store's namespace, verifies that:
+        - every medallion deserializes and matches its indexed id, status and
+          scope columns
+        - every history event belongs to an existing medallion
+        - every medallion's latest history event holds its current content
+
+        Returns:
+            IntegrityReport describing any problems found
+
+        Raises:
+            StoreError: If database query fails
+        """
+        await self._ensure_initialized()
+        assert self._conn is not None, "Connection must be initialized"
+
+        try:
+            async with self._conn.execute("PRAGMA integrity_check") as cursor:
+                integrity_errors = [
+                    row[0] for row in await cursor.fetchall() if row[0] != "ok"
+                ]
+            async with self._conn.execute("PRAGMA foreign_key_check") as cursor:
+                foreign_key_violations = [
+                    f"{row[0]} rowid {row[1]} references missing {row[2]}"
+                    for row in await cursor.fetchall()
+                ]
+
+            select_sql = """
+            SELECT id, content_json, status, scope_graph_nodes, scope_tags
+            FROM medallions
+            WHERE namespace = ?
+            ORDER BY id
+            """
+            invalid_medallion_ids: list[str] = []
+            async with self._conn.execute(select_sql, (self.namespace,)) as cursor:
+                async for row in cursor:
+                    try:
+                        medallion = Medallion.model_validate_json(row[1])
+                        consistent = (
+                            medallion.meta.medallion_id == row[0]
+                            and medallion.meta.status == row[2]
+                            and medallion.scope.graph_nodes == json.loads(row[3])
+                            and medallion.scope.tags == json.loads(row[4])
+                        )
+                    except Exception:
+                        consistent = False
+                    if not consistent:
+                        invalid_medallion_ids.append(row[0])
+
+            orphaned_sql = """
+            SELECT DISTINCT e.medallion_id
+            FROM medallion_events e
+            WHERE e.namespace = ?
+              AND NOT EXISTS (
+                SELECT 1 FROM medallions m
+                WHERE m.namespace = e.namespace AND m.id = e.medallion_id
+              )
+            ORDER BY e.medallion_id
+            """
+            async with self._conn.execute(orphaned_sql, (self.namespace,)) as cursor:
+                orphaned_event_medallion_ids = [row[0] for row in await cursor.fetchall()]
+
+            # IS NOT also flags medallions with no events at all (NULL subquery)
+            mismatch_sql = """
+            SELECT m.id
+            FROM medallions m
+            WHERE m.namespace = ?
+              AND m.content_json IS NOT (
+                SELECT e.content_json FROM medallion_events e
+                WHERE e.namespace = m.namespace AND e.medallion_id = m.id
+                ORDER BY e.seq DESC
+                LIMIT 1
+              )
+            ORDER BY m.id
+            """
+            async with self._conn.execute(mismatch_sql, (self.namespace,)) as cursor:
+                history_mismatch_medallion_ids = [row[0] for row in await cursor.fetchall()]
+        except sqlite3.Error as e:
+            raise StoreError(f"Failed to check database integrity: {e}") from e
+
+        return IntegrityReport(
+            integrity_errors=integrity_errors,
+            foreign_key_violations=foreign_key_violations,
+            invalid_medallion_ids=invalid_medallion_ids,
+            orphaned_event_medallion_ids=orphaned_event_medallion_ids,
+            history_mismatch_medallion_ids=history_mismatch_medallion_ids,
+        )
+
     async def close(self) -> None:
         """Close database connection."""
         if self._conn is not None:
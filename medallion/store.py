@@ -3,6 +3,8 @@ MedallionStore interface definition.
 
 This module defines the abstract Protocol for medallion storage operations.
 Concrete implementations (e.g., SQLiteMedallionStore) must satisfy this interface.
+Implementations may offer more: SQLiteMedallionStore's namespaces, history,
+backup and integrity operations are not part of this Protocol.
 """
 
 from typing import Protocol
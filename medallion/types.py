@@ -400,3 +400,48 @@ class Evidence(BaseModel):
         description="Optional structured info (e.g., file diffs, test results)",
     )
 
+
+class IntegrityReport(BaseModel):
+    """Result of a store integrity check.
+
+    Example:
+        ```python
+        report = await store.check_integrity()
+        if not report.ok:
+            print(report.integrity_errors, report.invalid_medallion_ids)
+        ```
+    """
+
+    integrity_errors: list[str] = Field(
+        default_factory=list,
+        description="Problems reported by SQLite's integrity_check pragma",
+    )
+    foreign_key_violations: list[str] = Field(
+        default_factory=list,
+        description="Rows reported by SQLite's foreign_key_check pragma",
+    )
+    invalid_medallion_ids: list[str] = Field(
+        default_factory=list,
+        description="IDs of rows whose content cannot be deserialized or disagrees "
+        "with the indexed columns",
+    )
+    orphaned_event_medallion_ids: list[str] = Field(
+        default_factory=list,
+        description="IDs with history events but no medallion row",
+    )
+    history_mismatch_medallion_ids: list[str] = Field(
+        default_factory=list,
+        description="IDs of medallions whose latest history event differs from the "
+        "stored row (or which have no history)",
+    )
+
+    @property
+    def ok(self) -> bool:
+        """True if no problems were found."""
+        return not (
+            self.integrity_errors
+            or self.foreign_key_violations
+            or self.invalid_medallion_ids
+            or self.orphaned_event_medallion_ids
+            or self.history_mismatch_medallion_ids
+        )
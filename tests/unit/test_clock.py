@@ -0,0 +1,55 @@
+"""Unit tests for Clock implementations."""
+
+from datetime import datetime, timedelta
+
+import pytest
+
+from medallion.clock import Clock, DeterministicClock, SystemClock
+
+
+class TestSystemClock:
+    """Tests for SystemClock."""
+
+    def test_now_returns_current_time(self) -> None:
+        """Test that SystemClock.now() tracks datetime.now()."""
+        clock = SystemClock()
+        before = datetime.now()
+        now = clock.now()
+        after = datetime.now()
+        assert before <= now <= after
+
+
+class TestDeterministicClock:
+    """Tests for DeterministicClock."""
+
+    def test_now_returns_start_without_step(self) -> None:
+        """Test that the clock stays put when no step is given."""
+        start = datetime(2025, 1, 1, 12, 0)
+        clock = DeterministicClock(start)
+        assert clock.now() == start
+        assert clock.now() == start
+
+    def test_now_advances_by_step(self) -> None:
+        """Test that each call advances the clock by step."""
+        start = datetime(2025, 1, 1, 12, 0)
+        clock = DeterministicClock(start, step=timedelta(seconds=5))
+        assert [clock.now() for _ in range(3)] == [
+            start,
+            start + timedelta(seconds=5),
+            start + timedelta(seconds=10),
+        ]
+
+    def test_negative_step_raises_error(self) -> None:
+        """Test that a negative step is rejected."""
+        with pytest.raises(ValueError, match="step cannot be negative"):
+            DeterministicClock(datetime(2025, 1, 1), step=timedelta(seconds=-1))
+
+
+class TestClockProtocol:
+    """Tests verifying implementations satisfy the Clock Protocol."""
+
+    def test_implementations_satisfy_protocol(self) -> None:
+        """Test that SystemClock and DeterministicClock satisfy Clock."""
+        clocks: list[Clock] = [SystemClock(), DeterministicClock(datetime(2025, 1, 1))]
+        for clock in clocks:
+            assert isinstance(clock.now(), datetime)
@@ -0,0 +1,56 @@
+"""Unit tests for IDGenerator implementations."""
+
+import re
+
+from medallion.ids import IDGenerator, SequentialIDGenerator, UUIDGenerator
+
+
+class TestUUIDGenerator:
+    """Tests for UUIDGenerator."""
+
+    def test_new_id_format(self) -> None:
+        """Test that IDs look like med-<12 hex chars>."""
+        medallion_id = UUIDGenerator().new_id()
+        assert re.fullmatch(r"med-[0-9a-f]{12}", medallion_id)
+
+    def test_new_id_is_unique(self) -> None:
+        """Test that repeated calls return different IDs."""
+        generator = UUIDGenerator()
+        ids = {generator.new_id() for _ in range(100)}
+        assert len(ids) == 100
+
+
+class TestSequentialIDGenerator:
+    """Tests for SequentialIDGenerator."""
+
+    def test_new_id_sequence(self) -> None:
+        """Test that IDs are numbered from 1 by default."""
+        generator = SequentialIDGenerator()
+        assert [generator.new_id() for _ in range(3)] == [
+            "med-000001",
+            "med-000002",
+            "med-000003",
+        ]
+
+    def test_custom_prefix_and_start(self) -> None:
+        """Test that prefix and start are respected."""
+        generator = SequentialIDGenerator(prefix="test-", start=42)
+        assert generator.new_id() == "test-000042"
+        assert generator.new_id() == "test-000043"
+
+    def test_separate_generators_are_independent(self) -> None:
+        """Test that each generator keeps its own counter."""
+        first = SequentialIDGenerator()
+        second = SequentialIDGenerator()
+        first.new_id()
+        assert second.new_id() == "med-000001"
+
+
+class TestIDGeneratorProtocol:
+    """Tests verifying implementations satisfy the IDGenerator Protocol."""
+
+    def test_implementations_satisfy_protocol(self) -> None:
+        """Test that UUIDGenerator and SequentialIDGenerator satisfy IDGenerator."""
+        generators: list[IDGenerator] = [UUIDGenerator(), SequentialIDGenerator()]
+        for generator in generators:
+            assert generator.new_id().startswith("med-")
@@ -1,9 +1,11 @@
 """Unit tests for MedallionLLM stub implementation."""
 
-from datetime import datetime
+from datetime import datetime, timedelta
 
 import pytest
 
+from medallion.clock import DeterministicClock
+from medallion.ids import SequentialIDGenerator
 from medallion.llm import StubMedallionLLM
 from medallion.types import (
     Evidence,
@@ -245,3 +247,63 @@ class TestStubMedallionLLMUpdate:
         assert updated.decisions == existing_medallion.decisions
         assert updated.open_questions == existing_medallion.open_questions
 
+
+class TestStubMedallionLLMDeterminism:
+    """Tests for injecting a clock and ID generator into StubMedallionLLM."""
+
+    @pytest.fixture
+    def deterministic_llm(self) -> StubMedallionLLM:
+        """Create a stub LLM with a deterministic clock and ID generator."""
+        return StubMedallionLLM(
+            clock=DeterministicClock(datetime(2025, 1, 1, 12, 0), step=timedelta(minutes=1)),
+            id_generator=SequentialIDGenerator(),
+        )
+
+    @pytest.mark.asyncio
+    async def test_generate_uses_injected_clock_and_ids(
+        self,
+        deterministic_llm: StubMedallionLLM,
+        sample_scope: MedallionScope,
+        sample_evidence: Evidence,
+    ) -> None:
+        """Test that generate takes its ID and timestamps from the injected sources."""
+        medallion = await deterministic_llm.generate(sample_scope, sample_evidence)
+
+        assert medallion.meta.medallion_id == "med-000001"
+        assert medallion.meta.created_at == datetime(2025, 1, 1, 12, 0)
+        assert medallion.meta.updated_at == datetime(2025, 1, 1, 12, 0)
+
+    @pytest.mark.asyncio
+    async def test_update_uses_injected_clock(
+        self,
+        deterministic_llm: StubMedallionLLM,
+        sample_scope: MedallionScope,
+        sample_evidence: Evidence,
+    ) -> None:
+        """Test that update stamps updated_at from the injected clock."""
+        medallion = await deterministic_llm.generate(sample_scope, sample_evidence)
+        updated = await deterministic_llm.update(
+            medallion, Evidence(session_summary="Second session")
+        )
+
+        assert updated.meta.medallion_id == "med-000001"
+        assert updated.meta.created_at == datetime(2025, 1, 1, 12, 0)
+        assert updated.meta.updated_at == datetime(2025, 1, 1, 12, 1)
+
+    @pytest.mark.asyncio
+    async def test_generate_is_reproducible(
+        self,
+        sample_scope: MedallionScope,
+        sample_evidence: Evidence,
+    ) -> None:
+        """Test that identically configured stubs produce identical medallions."""
+
+        def make_llm() -> StubMedallionLLM:
+            return StubMedallionLLM(
+                clock=DeterministicClock(datetime(2025, 1, 1)),
+                id_generator=SequentialIDGenerator(),
+            )
+
+        first = await make_llm().generate(sample_scope, sample_evidence)
+        second = await make_llm().generate(sample_scope, sample_evidence)
+        assert first.model_dump_json() == second.model_dump_json()
@@ -1,6 +1,8 @@
 """Unit tests for SQLiteMedallionStore implementation."""
 
-from datetime import datetime, timedelta
+import asyncio
+import sqlite3
+from datetime import datetime, timedelta, timezone
 from pathlib import Path
 
 import pytest
@@ -55,6 +57,45 @@ def sample_medallion() -> Medallion:
     )
 
 
+@pytest.fixture
+def legacy_db_path(tmp_path: Path, sample_medallion: Medallion) -> Path:
+    """Create a database from before namespaces, holding sample_medallion."""
+    db_path = tmp_path / "legacy.db"
+    conn = sqlite3.connect(db_path)
+    conn.execute(
+        """
+        CREATE TABLE medallions (
+            id TEXT PRIMARY KEY,
+            content_json TEXT NOT NULL,
+            created_at TEXT NOT NULL,
+            updated_at TEXT NOT NULL,
+            status TEXT NOT NULL,
+            scope_graph_nodes TEXT NOT NULL,
+            scope_tags TEXT NOT NULL,
+            knowledge_min_ts TEXT,
+            knowledge_max_ts TEXT,
+            schema_version TEXT NOT NULL DEFAULT 'medallion.v1'
+        )
+        """
+    )
+    conn.execute(
+        "INSERT INTO medallions VALUES (?, ?, ?, ?, ?, ?, ?, NULL, NULL, ?)",
+        (
+            sample_medallion.meta.medallion_id,
+            sample_medallion.model_dump_json(),
+            sample_medallion.meta.created_at.isoformat(),
+            sample_medallion.meta.updated_at.isoformat(),
+            "active",
+            '["repo:muse"]',
+            '["project_state"]',
+            "medallion.v1",
+        ),
+    )
+    conn.commit()
+    conn.close()
+    return db_path
+
+
 class TestSQLiteMedallionStoreCreate:
     """Tests for SQLiteMedallionStore.create()."""
 
@@ -837,6 +878,585 @@ class TestSQLiteMedallionStoreGetLatestForScope:
         assert len(results3) == 0
 
 
+class TestSQLiteMedallionStoreNamespace:
+    """Tests for namespace isolation in SQLiteMedallionStore."""
+
+    def test_default_namespace(self) -> None:
+        """Test that stores use the default namespace unless told otherwise."""
+        store = SQLiteMedallionStore(":memory:")
+        assert store.namespace == "default"
+
+    def test_empty_namespace_raises_error(self) -> None:
+        """Test that an empty namespace is rejected."""
+        with pytest.raises(StoreError, match="Namespace cannot be empty"):
+            SQLiteMedallionStore(":memory:", namespace="  ")
+
+    @pytest.mark.parametrize("namespace", [" project-a", "project-a ", "project-a\n"])
+    def test_namespace_with_surrounding_whitespace_raises_error(self, namespace: str) -> None:
+        """Test that namespaces with leading/trailing whitespace are rejected."""
+        with pytest.raises(StoreError, match="leading or trailing whitespace"):
+            SQLiteMedallionStore(":memory:", namespace=namespace)
+
+    @pytest.mark.asyncio
+    async def test_namespaces_isolate_get_by_id(
+        self, tmp_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that a medallion is only visible from its own namespace."""
+        db_path = tmp_path / "shared.db"
+        async with SQLiteMedallionStore(db_path, namespace="project-a") as store_a:
+            async with SQLiteMedallionStore(db_path, namespace="project-b") as store_b:
+                await store_a.create(sample_medallion)
+
+                assert await store_a.get_by_id("med-001") is not None
+                assert await store_b.get_by_id("med-001") is None
+
+    @pytest.mark.asyncio
+    async def test_namespaces_isolate_scope_queries(
+        self, tmp_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that scope queries only return medallions from the store's namespace."""
+        db_path = tmp_path / "shared.db"
+        other = sample_medallion.model_copy(
+            update={"meta": sample_medallion.meta.model_copy(update={"medallion_id": "med-002"})}
+        )
+        async with SQLiteMedallionStore(db_path, namespace="project-a") as store_a:
+            async with SQLiteMedallionStore(db_path, namespace="project-b") as store_b:
+                await store_a.create(sample_medallion)
+                await store_b.create(other)
+
+                results_a = await store_a.get_latest_for_scope(sample_medallion.scope)
+                results_b = await store_b.get_latest_for_scope(sample_medallion.scope)
+
+                assert [m.meta.medallion_id for m in results_a] == ["med-001"]
+                assert [m.meta.medallion_id for m in results_b] == ["med-002"]
+
+    @pytest.mark.asyncio
+    async def test_same_id_in_different_namespaces(
+        self, tmp_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that two namespaces can each hold a medallion with the same ID."""
+        db_path = tmp_path / "shared.db"
+        other = sample_medallion.model_copy(
+            update={"summary": MedallionSummary(high_level="Project B summary", subsystems=[])}
+        )
+        async with SQLiteMedallionStore(db_path, namespace="project-a") as store_a:
+            async with SQLiteMedallionStore(db_path, namespace="project-b") as store_b:
+                await store_a.create(sample_medallion)
+                await store_b.create(other)
+
+                retrieved_a = await store_a.get_by_id("med-001")
+                retrieved_b = await store_b.get_by_id("med-001")
+                assert retrieved_a is not None
+                assert retrieved_a.summary.high_level == "Test summary"
+                assert retrieved_b is not None
+                assert retrieved_b.summary.high_level == "Project B summary"
+
+                await store_b.update(other.model_copy(update={"summary": sample_medallion.summary}))
+                retrieved_a = await store_a.get_by_id("med-001")
+                assert retrieved_a is not None
+                assert retrieved_a.summary.high_level == "Test summary"
+
+    @pytest.mark.asyncio
+    async def test_update_does_not_cross_namespaces(
+        self, tmp_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that a store cannot update a medallion owned by another namespace."""
+        db_path = tmp_path / "shared.db"
+        async with SQLiteMedallionStore(db_path, namespace="project-a") as store_a:
+            async with SQLiteMedallionStore(db_path, namespace="project-b") as store_b:
+                await store_a.create(sample_medallion)
+
+                with pytest.raises(StoreError, match="not found"):
+                    await store_b.update(sample_medallion)
+
+    @pytest.mark.asyncio
+    async def test_scope_index_leads_with_namespace(
+        self, in_memory_store: SQLiteMedallionStore
+    ) -> None:
+        """Test that scope queries have an index on (namespace, status, updated_at)."""
+        await in_memory_store.get_by_id("med-001")
+        assert in_memory_store._conn is not None
+        async with in_memory_store._conn.execute(
+            "PRAGMA index_info(idx_medallions_namespace_status_updated_at)"
+        ) as cursor:
+            columns = [row[2] for row in await cursor.fetchall()]
+
+        assert columns == ["namespace", "status", "updated_at"]
+
+    @pytest.mark.asyncio
+    async def test_legacy_database_migrates_to_default_namespace(
+        self, legacy_db_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that rows from a pre-namespace database land in the default namespace."""
+        async with SQLiteMedallionStore(legacy_db_path) as store:
+            assert await store.get_by_id("med-001") is not None
+        async with SQLiteMedallionStore(legacy_db_path, namespace="other") as store:
+            assert await store.get_by_id("med-001") is None
+            # The rebuilt table is keyed by (namespace, id)
+            await store.create(sample_medallion)
+            assert await store.get_by_id("med-001") is not None
+
+    @pytest.mark.asyncio
+    async def test_concurrent_opens_migrate_legacy_database_once(
+        self, legacy_db_path: Path
+    ) -> None:
+        """Test that stores opening a legacy database at once don't both rebuild it."""
+        async with SQLiteMedallionStore(legacy_db_path) as store_a:
+            async with SQLiteMedallionStore(legacy_db_path) as store_b:
+                retrieved_a, retrieved_b = await asyncio.gather(
+                    store_a.get_by_id("med-001"), store_b.get_by_id("med-001")
+                )
+
+        assert retrieved_a is not None
+        assert retrieved_b is not None
+
+    @pytest.mark.asyncio
+    async def test_legacy_namespace_column_is_preserved(
+        self, tmp_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that a namespace column outside the primary key keeps its values."""
+        db_path = tmp_path / "legacy.db"
+        conn = sqlite3.connect(db_path)
+        conn.execute(
+            """
+            CREATE TABLE medallions (
+                id TEXT PRIMARY KEY,
+                content_json TEXT NOT NULL,
+                created_at TEXT NOT NULL,
+                updated_at TEXT NOT NULL,
+                status TEXT NOT NULL,
+                scope_graph_nodes TEXT NOT NULL,
+                scope_tags TEXT NOT NULL,
+                knowledge_min_ts TEXT,
+                knowledge_max_ts TEXT,
+                schema_version TEXT NOT NULL DEFAULT 'medallion.v1',
+                namespace TEXT NOT NULL DEFAULT 'default'
+            )
+            """
+        )
+        conn.execute(
+            "INSERT INTO medallions VALUES (?, ?, ?, ?, ?, ?, ?, NULL, NULL, ?, ?)",
+            (
+                "med-001",
+                sample_medallion.model_dump_json(),
+                sample_medallion.meta.created_at.isoformat(),
+                sample_medallion.meta.updated_at.isoformat(),
+                "active",
+                '["repo:muse"]',
+                '["project_state"]',
+                "medallion.v1",
+                "project-a",
+            ),
+        )
+        conn.commit()
+        conn.close()
+
+        async with SQLiteMedallionStore(db_path, namespace="project-a") as store:
+            assert await store.get_by_id("med-001") is not None
+        async with SQLiteMedallionStore(db_path) as store:
+            assert await store.get_by_id("med-001") is None
+
+    @pytest.mark.asyncio
+    async def test_failed_migration_leaves_legacy_rows_intact(
+        self, tmp_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that a rebuild failing part-way is rolled back rather than half-committed."""
+        db_path = tmp_path / "legacy.db"
+        conn = sqlite3.connect(db_path)
+        # Loosely typed legacy table: the row with a NULL status violates the new
+        # table's NOT NULL constraint, so the copy step of the rebuild fails
+        conn.execute(
+            """
+            CREATE TABLE medallions (
+                id TEXT PRIMARY KEY,
+                content_json TEXT,
+                created_at TEXT,
+                updated_at TEXT,
+                status TEXT,
+                scope_graph_nodes TEXT,
+                scope_tags TEXT,
+                knowledge_min_ts TEXT,
+                knowledge_max_ts TEXT,
+                schema_version TEXT
+            )
+            """
+        )
+        row = (
+            sample_medallion.model_dump_json(),
+            sample_medallion.meta.created_at.isoformat(),
+            sample_medallion.meta.updated_at.isoformat(),
+        )
+        conn.executemany(
+            "INSERT INTO medallions VALUES (?, ?, ?, ?, ?, ?, ?, NULL, NULL, ?)",
+            [
+                ("med-001", *row, "active", '["repo:muse"]', '["project_state"]', "medallion.v1"),
+                ("med-broken", *row, None, '["repo:muse"]', '["project_state"]', "medallion.v1"),
+            ],
+        )
+        conn.commit()
+        conn.close()
+
+        store = SQLiteMedallionStore(db_path, namespace="other")
+        with pytest.raises(StoreError, match="Failed to initialize database"):
+            await store.get_by_id("med-001")
+        assert store._conn is None
+
+        # A later write retries initialization instead of committing the partial rebuild
+        with pytest.raises(StoreError, match="Failed to initialize database"):
+            await store.create(sample_medallion)
+        await store.close()
+
+        conn = sqlite3.connect(db_path)
+        tables = {
+            name for (name,) in conn.execute("SELECT name FROM sqlite_master WHERE type = 'table'")
+        }
+        ids = [row[0] for row in conn.execute("SELECT id FROM medallions ORDER BY id")]
+        conn.close()
+        assert "medallions_legacy" not in tables
+        assert ids == ["med-001", "med-broken"]
+
+
+class TestSQLiteMedallionStoreHistory:
+    """Tests for SQLiteMedallionStore.get_history() and get_as_of()."""
+
+    @pytest.fixture
+    def updated_medallion(self, sample_medallion: Medallion) -> Medallion:
+        """A later version of sample_medallion with a new summary."""
+        return sample_medallion.model_copy(
+            update={
+                "meta": sample_medallion.meta.model_copy(
+                    update={"updated_at": sample_medallion.meta.updated_at + timedelta(hours=1)}
+                ),
+                "summary": MedallionSummary(high_level="Updated summary", subsystems=[]),
+            }
+        )
+
+    @pytest.mark.asyncio
+    async def test_get_history_returns_all_versions_oldest_first(
+        self,
+        in_memory_store: SQLiteMedallionStore,
+        sample_medallion: Medallion,
+        updated_medallion: Medallion,
+    ) -> None:
+        """Test that every create and update is kept in the history."""
+        await in_memory_store.create(sample_medallion)
+        await in_memory_store.update(updated_medallion)
+
+        history = await in_memory_store.get_history("med-001")
+        assert [m.summary.high_level for m in history] == ["Test summary", "Updated summary"]
+
+    @pytest.mark.asyncio
+    async def test_get_history_returns_empty_for_nonexistent(
+        self, in_memory_store: SQLiteMedallionStore
+    ) -> None:
+        """Test that get_history returns an empty list for unknown IDs."""
+        assert await in_memory_store.get_history("nonexistent") == []
+
+    @pytest.mark.asyncio
+    async def test_get_as_of_returns_version_current_at_time(
+        self,
+        in_memory_store: SQLiteMedallionStore,
+        sample_medallion: Medallion,
+        updated_medallion: Medallion,
+    ) -> None:
+        """Test that get_as_of picks the latest version at or before the given time."""
+        await in_memory_store.create(sample_medallion)
+        await in_memory_store.update(updated_medallion)
+        created = sample_medallion.meta.updated_at
+
+        before = await in_memory_store.get_as_of("med-001", created - timedelta(seconds=1))
+        at_create = await in_memory_store.get_as_of("med-001", created)
+        between = await in_memory_store.get_as_of("med-001", created + timedelta(minutes=30))
+        after = await in_memory_store.get_as_of("med-001", created + timedelta(days=1))
+
+        assert before is None
+        assert at_create is not None and at_create.summary.high_level == "Test summary"
+        assert between is not None and between.summary.high_level == "Test summary"
+        assert after is not None and after.summary.high_level == "Updated summary"
+
+    @pytest.mark.asyncio
+    async def test_get_as_of_compares_aware_timestamps_by_instant(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion
+    ) -> None:
+        """Test that get_as_of orders aware timestamps with different offsets correctly."""
+        utc = timezone.utc
+        plus_one = timezone(timedelta(hours=1))
+        plus_two = timezone(timedelta(hours=2))
+        created = datetime(2025, 1, 1, 12, 0, tzinfo=utc)
+        first = sample_medallion.model_copy(
+            update={
+                "meta": sample_medallion.meta.model_copy(
+                    update={"created_at": created, "updated_at": created}
+                )
+            }
+        )
+        # 15:00+02:00 is 13:00 UTC
+        second = first.model_copy(
+            update={
+                "meta": first.meta.model_copy(
+                    update={"updated_at": datetime(2025, 1, 1, 15, 0, tzinfo=plus_two)}
+                ),
+                "summary": MedallionSummary(high_level="Updated summary", subsystems=[]),
+            }
+        )
+        await in_memory_store.create(first)
+        await in_memory_store.update(second)
+
+        # 14:30+01:00 is 13:30 UTC, after the update even though it sorts before
+        # "15:00+02:00" as a string
+        after_update = await in_memory_store.get_as_of(
+            "med-001", datetime(2025, 1, 1, 14, 30, tzinfo=plus_one)
+        )
+        at_update = await in_memory_store.get_as_of(
+            "med-001", datetime(2025, 1, 1, 13, 0, tzinfo=utc)
+        )
+        before_update = await in_memory_store.get_as_of(
+            "med-001", datetime(2025, 1, 1, 13, 59, tzinfo=plus_one)
+        )
+
+        assert after_update is not None
+        assert after_update.summary.high_level == "Updated summary"
+        assert at_update is not None
+        assert at_update.summary.high_level == "Updated summary"
+        assert before_update is not None
+        assert before_update.summary.high_level == "Test summary"
+
+    @pytest.mark.asyncio
+    async def test_get_as_of_rejects_naive_and_aware_mismatch(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion
+    ) -> None:
+        """Test that comparing a naive as_of with aware stored timestamps raises."""
+        created = datetime(2025, 1, 1, 12, 0, tzinfo=timezone.utc)
+        aware = sample_medallion.model_copy(
+            update={
+                "meta": sample_medallion.meta.model_copy(
+                    update={"created_at": created, "updated_at": created}
+                )
+            }
+        )
+        await in_memory_store.create(aware)
+
+        with pytest.raises(StoreError, match="Cannot compare naive as_of"):
+            await in_memory_store.get_as_of("med-001", datetime(2025, 1, 1, 12, 0))
+
+    @pytest.mark.asyncio
+    async def test_get_as_of_rejects_mismatch_with_backfilled_created_at(
+        self, tmp_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that a backfilled version's created_at gets the same awareness check."""
+        db_path = tmp_path / "legacy.db"
+        updated = datetime(2025, 1, 1, 13, 0, tzinfo=timezone.utc)
+        async with SQLiteMedallionStore(db_path) as store:
+            # The stored JSON is naive while the indexed updated_at column is aware
+            await store.create(sample_medallion)
+            assert store._conn is not None
+            await store._conn.execute(
+                "UPDATE medallions SET updated_at = ?", (updated.isoformat(),)
+            )
+            await store._conn.execute("DROP TABLE medallion_events")
+            await store._conn.commit()
+
+        async with SQLiteMedallionStore(db_path) as store:
+            with pytest.raises(StoreError, match="Cannot compare timezone-aware as_of"):
+                await store.get_as_of("med-001", updated - timedelta(hours=1))
+
+    @pytest.mark.asyncio
+    async def test_history_is_namespaced(
+        self, tmp_path: Path, sample_medallion: Medallion
+    ) -> None:
+        """Test that history from another namespace is not visible."""
+        db_path = tmp_path / "shared.db"
+        async with SQLiteMedallionStore(db_path, namespace="project-a") as store_a:
+            async with SQLiteMedallionStore(db_path, namespace="project-b") as store_b:
+                await store_a.create(sample_medallion)
+
+                assert len(await store_a.get_history("med-001")) == 1
+                assert await store_b.get_history("med-001") == []
+
+    @pytest.mark.asyncio
+    async def test_events_are_append_only(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion
+    ) -> None:
+        """Test that the event log rejects updates and deletes."""
+        await in_memory_store.create(sample_medallion)
+        assert in_memory_store._conn is not None
+
+        with pytest.raises(sqlite3.IntegrityError, match="append-only"):
+            await in_memory_store._conn.execute("DELETE FROM medallion_events")
+        with pytest.raises(sqlite3.IntegrityError, match="append-only"):
+            await in_memory_store._conn.execute("UPDATE medallion_events SET event_type = 'x'")
+
+    @pytest.mark.asyncio
+    async def test_existing_medallions_are_backfilled(
+        self, tmp_path: Path, updated_medallion: Medallion
+    ) -> None:
+        """Test that medallions written before the event log existed get a history entry."""
+        db_path = tmp_path / "legacy.db"
+        async with SQLiteMedallionStore(db_path) as store:
+            await store.create(updated_medallion)
+            assert store._conn is not None
+            await store._conn.execute("DROP TABLE medallion_events")
+            await store._conn.commit()
+
+        async with SQLiteMedallionStore(db_path) as store:
+            history = await store.get_history("med-001")
+            assert len(history) == 1
+            assert history[0].summary.high_level == "Updated summary"
+
+        # Reopening must not backfill again
+        async with SQLiteMedallionStore(db_path) as store:
+            assert len(await store.get_history("med-001")) == 1
+
+    @pytest.mark.asyncio
+    async def test_concurrent_opens_backfill_once(
+        self, tmp_path: Path, updated_medallion: Medallion
+    ) -> None:
+        """Test that stores opening a database without an event log at once backfill it once."""
+        db_path = tmp_path / "legacy.db"
+        async with SQLiteMedallionStore(db_path) as store:
+            await store.create(updated_medallion)
+            assert store._conn is not None
+            await store._conn.execute("DROP TABLE medallion_events")
+            await store._conn.commit()
+
+        async with SQLiteMedallionStore(db_path) as store_a:
+            async with SQLiteMedallionStore(db_path) as store_b:
+                await asyncio.gather(
+                    store_a.get_by_id("med-001"), store_b.get_by_id("med-001")
+                )
+                assert len(await store_a.get_history("med-001")) == 1
+
+    @pytest.mark.asyncio
+    async def test_get_as_of_uses_backfilled_version_since_creation(
+        self, tmp_path: Path, updated_medallion: Medallion
+    ) -> None:
+        """Test that a backfilled medallion is visible from its created_at onwards."""
+        db_path = tmp_path / "legacy.db"
+        async with SQLiteMedallionStore(db_path) as store:
+            await store.create(updated_medallion)
+            assert store._conn is not None
+            await store._conn.execute("DROP TABLE medallion_events")
+            await store._conn.commit()
+
+        created = updated_medallion.meta.created_at
+        async with SQLiteMedallionStore(db_path) as store:
+            at_create = await store.get_as_of("med-001", created)
+            before_create = await store.get_as_of("med-001", created - timedelta(seconds=1))
+
+            assert at_create is not None
+            assert at_create.summary.high_level == "Updated summary"
+            assert before_create is None
+
+
+class TestSQLiteMedallionStoreBackup:
+    """Tests for SQLiteMedallionStore.backup()."""
+
+    @pytest.mark.asyncio
+    async def test_backup_copies_medallions(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion, tmp_path: Path
+    ) -> None:
+        """Test that a backup can be opened as a store with the same medallions."""
+        await in_memory_store.create(sample_medallion)
+        backup_path = tmp_path / "backup.db"
+
+        await in_memory_store.backup(backup_path)
+
+        async with SQLiteMedallionStore(backup_path) as restored:
+            retrieved = await restored.get_by_id("med-001")
+            assert retrieved is not None
+            assert retrieved.summary.high_level == sample_medallion.summary.high_level
+
+    @pytest.mark.asyncio
+    async def test_backup_raises_error_on_unwritable_target(
+        self, in_memory_store: SQLiteMedallionStore, tmp_path: Path
+    ) -> None:
+        """Test that backup wraps SQLite failures in StoreError."""
+        with pytest.raises(StoreError, match="Failed to back up"):
+            await in_memory_store.backup(tmp_path / "missing" / "backup.db")
+
+
+class TestSQLiteMedallionStoreCheckIntegrity:
+    """Tests for SQLiteMedallionStore.check_integrity()."""
+
+    @pytest.mark.asyncio
+    async def test_check_integrity_passes_for_healthy_database(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion
+    ) -> None:
+        """Test that a healthy database produces a clean report."""
+        await in_memory_store.create(sample_medallion)
+        report = await in_memory_store.check_integrity()
+        assert report.ok
+        assert report.integrity_errors == []
+        assert report.foreign_key_violations == []
+        assert report.invalid_medallion_ids == []
+        assert report.orphaned_event_medallion_ids == []
+        assert report.history_mismatch_medallion_ids == []
+
+    @pytest.mark.asyncio
+    async def test_check_integrity_reports_undeserializable_content(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion
+    ) -> None:
+        """Test that rows with broken content_json are reported."""
+        await in_memory_store.create(sample_medallion)
+        assert in_memory_store._conn is not None
+        await in_memory_store._conn.execute(
+            "UPDATE medallions SET content_json = ? WHERE id = ?", ("{not json", "med-001")
+        )
+
+        report = await in_memory_store.check_integrity()
+        assert not report.ok
+        assert report.invalid_medallion_ids == ["med-001"]
+
+    @pytest.mark.asyncio
+    async def test_check_integrity_reports_stale_index_columns(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion
+    ) -> None:
+        """Test that rows whose indexed columns disagree with content are reported."""
+        await in_memory_store.create(sample_medallion)
+        assert in_memory_store._conn is not None
+        await in_memory_store._conn.execute(
+            "UPDATE medallions SET scope_tags = ? WHERE id = ?", ('["other"]', "med-001")
+        )
+
+        report = await in_memory_store.check_integrity()
+        assert report.invalid_medallion_ids == ["med-001"]
+
+    @pytest.mark.asyncio
+    async def test_check_integrity_reports_orphaned_events(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion
+    ) -> None:
+        """Test that history events without a medallion row are reported."""
+        await in_memory_store.create(sample_medallion)
+        assert in_memory_store._conn is not None
+        await in_memory_store._conn.execute("DELETE FROM medallions WHERE id = ?", ("med-001",))
+
+        report = await in_memory_store.check_integrity()
+        assert not report.ok
+        assert report.orphaned_event_medallion_ids == ["med-001"]
+        assert report.history_mismatch_medallion_ids == []
+
+    @pytest.mark.asyncio
+    async def test_check_integrity_reports_history_mismatch(
+        self, in_memory_store: SQLiteMedallionStore, sample_medallion: Medallion
+    ) -> None:
+        """Test that a row whose content differs from its latest event is reported."""
+        await in_memory_store.create(sample_medallion)
+        assert in_memory_store._conn is not None
+        changed = sample_medallion.model_copy(
+            update={"summary": MedallionSummary(high_level="Edited in place", subsystems=[])}
+        )
+        await in_memory_store._conn.execute(
+            "UPDATE medallions SET content_json = ? WHERE id = ?",
+            (changed.model_dump_json(), "med-001"),
+        )
+
+        report = await in_memory_store.check_integrity()
+        assert not report.ok
+        assert report.invalid_medallion_ids == []
+        assert report.orphaned_event_medallion_ids == []
+        assert report.history_mismatch_medallion_ids == ["med-001"]
+
+
 class TestSQLiteMedallionStoreProtocol:
     """Tests verifying SQLiteMedallionStore satisfies MedallionStore Protocol."""
 